@@ -0,0 +1,27 @@
+package swagger
+
+import "testing"
+
+// The repo ships embed/swagger-ui with placeholder stubs until `go generate
+// ./...` populates real swagger-ui-dist assets, so these tests exercise the
+// guard against that exact checked-in state rather than a fixture.
+
+func TestValidateEmbeddedAssets_RejectsPlaceholderStubs(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected validateEmbeddedAssets to panic against the checked-in placeholder stubs")
+		}
+	}()
+
+	validateEmbeddedAssets()
+}
+
+func TestNew_UseEmbeddedAssetsRejectsPlaceholderStubs(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected New to panic when UseEmbeddedAssets is set against the checked-in placeholder stubs")
+		}
+	}()
+
+	New(Config{UseEmbeddedAssets: true})
+}
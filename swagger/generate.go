@@ -0,0 +1,112 @@
+//go:build ignore
+
+// Command generate downloads the pinned swagger-ui-dist release, strips it
+// down to the files this middleware actually serves, and writes them under
+// embed/swagger-ui for swagger.go's //go:embed to pick up. Run it with:
+//
+//	go generate ./...
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// swaggerUIVersion must match the embeddedUIVersion constant in swagger.go
+// so Config.UIVersion can validate against what's actually embedded.
+const (
+	swaggerUIVersion = "5.17.14"
+	swaggerUITarURL  = "https://github.com/swagger-api/swagger-ui/archive/refs/tags/v" + swaggerUIVersion + ".tar.gz"
+	distPrefix       = "swagger-ui-" + swaggerUIVersion + "/dist/"
+	outDir           = "embed/swagger-ui"
+)
+
+// wantedFiles are the only dist assets the middleware references; source
+// maps, the OAuth2 redirect page, and the other presets are dropped.
+var wantedFiles = []string{
+	"swagger-ui-bundle.js",
+	"swagger-ui-standalone-preset.js",
+	"swagger-ui.css",
+	"favicon-16x16.png",
+	"favicon-32x32.png",
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "generate:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	resp, err := http.Get(swaggerUITarURL)
+	if err != nil {
+		return fmt.Errorf("download %s: %w", swaggerUITarURL, err)
+	}
+	defer resp.Body.Close()
+
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return fmt.Errorf("ungzip swagger-ui tarball: %w", err)
+	}
+	defer gz.Close()
+
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", outDir, err)
+	}
+
+	want := make(map[string]bool, len(wantedFiles))
+	for _, f := range wantedFiles {
+		want[f] = true
+	}
+
+	tr := tar.NewReader(gz)
+	written := 0
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read tar entry: %w", err)
+		}
+
+		if len(hdr.Name) <= len(distPrefix) || hdr.Name[:len(distPrefix)] != distPrefix {
+			continue
+		}
+		base := hdr.Name[len(distPrefix):]
+		if !want[base] {
+			continue
+		}
+
+		if err := writeFile(filepath.Join(outDir, base), tr); err != nil {
+			return err
+		}
+		written++
+	}
+
+	if written != len(wantedFiles) {
+		return fmt.Errorf("expected %d assets, wrote %d - swagger-ui's dist layout may have changed", len(wantedFiles), written)
+	}
+
+	fmt.Printf("wrote swagger-ui %s assets to %s\n", swaggerUIVersion, outDir)
+	return nil
+}
+
+func writeFile(path string, r io.Reader) error {
+	out, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", path, err)
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, r); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package swagger
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func newMultiSpecApp() *fiber.App {
+	app := fiber.New()
+	app.Use(New(Config{
+		BasePath: "/",
+		Path:     "docs",
+		Title:    "Multi-spec test",
+		Specs: []SpecEntry{
+			{Name: "v1", FilePath: "testdata/v1.json"},
+			{Name: "v2", FilePath: "testdata/v2.json"},
+		},
+	}))
+	return app
+}
+
+func TestNew_MultiSpecRouting(t *testing.T) {
+	app := newMultiSpecApp()
+
+	tests := []struct {
+		path        string
+		wantContain string
+	}{
+		{"/v1.json", `"title": "V1 API"`},
+		{"/v2.json", `"title": "V2 API"`},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test(%q) error = %v", tt.path, err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("reading body for %q: %v", tt.path, err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("GET %s status = %d, want 200; body = %s", tt.path, resp.StatusCode, body)
+		}
+		if !strings.Contains(string(body), tt.wantContain) {
+			t.Errorf("GET %s body = %s, want to contain %q", tt.path, body, tt.wantContain)
+		}
+	}
+}
+
+func TestNew_MultiSpecVersionSelectorListsEverySpec(t *testing.T) {
+	app := newMultiSpecApp()
+
+	req := httptest.NewRequest(http.MethodGet, "/docs", nil)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body: %v", err)
+	}
+
+	for _, want := range []string{"/v1.json", "/v2.json"} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("version selector body = %s, want to contain %q", body, want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package swagger
+
+import "testing"
+
+func TestFileExt(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"swagger.json", ".json"},
+		{"./docs/swagger.json", ".json"},
+		{"swagger.yaml", ".yaml"},
+		{"swagger.yml", ".yaml"},
+		{"swagger", ".yaml"},
+	}
+
+	for _, tt := range tests {
+		if got := fileExt(tt.path); got != tt.want {
+			t.Errorf("fileExt(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestRequestExt(t *testing.T) {
+	tests := []struct {
+		requestPath string
+		fallback    string
+		want        string
+	}{
+		{"/docs/swagger.json", ".yaml", ".json"},
+		{"/docs/swagger.yaml", ".json", ".yaml"},
+		{"/docs/swagger.yml", ".json", ".yaml"},
+		{"/docs/swagger", ".json", ".json"},
+		{"/docs/swagger", ".yaml", ".yaml"},
+	}
+
+	for _, tt := range tests {
+		if got := requestExt(tt.requestPath, tt.fallback); got != tt.want {
+			t.Errorf("requestExt(%q, %q) = %q, want %q", tt.requestPath, tt.fallback, got, tt.want)
+		}
+	}
+}
+
+func TestRewriteHostScheme_JSON(t *testing.T) {
+	raw := []byte(`{"host":"example.com","schemes":["https"],"info":{"title":"t"}}`)
+
+	out, err := rewriteHostScheme(raw, ".json", "rewritten.example.com", "http")
+	if err != nil {
+		t.Fatalf("rewriteHostScheme() error = %v", err)
+	}
+
+	doc, err := decodeSpec(out, ".json")
+	if err != nil {
+		t.Fatalf("decodeSpec() error = %v", err)
+	}
+
+	if doc["host"] != "rewritten.example.com" {
+		t.Errorf("host = %v, want rewritten.example.com", doc["host"])
+	}
+
+	schemes, ok := doc["schemes"].([]interface{})
+	if !ok || len(schemes) != 1 || schemes[0] != "http" {
+		t.Errorf("schemes = %v, want [http]", doc["schemes"])
+	}
+
+	// The original bytes must be left untouched.
+	origDoc, err := decodeSpec(raw, ".json")
+	if err != nil {
+		t.Fatalf("decodeSpec(raw) error = %v", err)
+	}
+	if origDoc["host"] != "example.com" {
+		t.Errorf("rewriteHostScheme mutated the original spec: host = %v", origDoc["host"])
+	}
+}
+
+func TestRewriteHostScheme_YAML(t *testing.T) {
+	raw := []byte("servers:\n  - url: https://example.com/v1\ninfo:\n  title: t\n")
+
+	out, err := rewriteHostScheme(raw, ".yaml", "rewritten.example.com", "http")
+	if err != nil {
+		t.Fatalf("rewriteHostScheme() error = %v", err)
+	}
+
+	doc, err := decodeSpec(out, ".yaml")
+	if err != nil {
+		t.Fatalf("decodeSpec() error = %v", err)
+	}
+
+	servers, ok := doc["servers"].([]interface{})
+	if !ok || len(servers) != 1 {
+		t.Fatalf("servers = %v, want one entry", doc["servers"])
+	}
+
+	entry, ok := servers[0].(map[string]interface{})
+	if !ok {
+		t.Fatalf("servers[0] = %v, want a map", servers[0])
+	}
+
+	want := "http://rewritten.example.com/v1"
+	if entry["url"] != want {
+		t.Errorf("servers[0].url = %v, want %v", entry["url"], want)
+	}
+}
+
+func TestRewriteHostScheme_EmptyHostOrSchemeLeavesItUnchanged(t *testing.T) {
+	raw := []byte(`{"host":"example.com","schemes":["https"]}`)
+
+	out, err := rewriteHostScheme(raw, ".json", "", "http")
+	if err != nil {
+		t.Fatalf("rewriteHostScheme() error = %v", err)
+	}
+
+	doc, err := decodeSpec(out, ".json")
+	if err != nil {
+		t.Fatalf("decodeSpec() error = %v", err)
+	}
+
+	if doc["host"] != "example.com" {
+		t.Errorf("host = %v, want unchanged example.com", doc["host"])
+	}
+}
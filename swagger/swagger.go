@@ -1,8 +1,15 @@
+// Package swagger serves an OpenAPI spec and a documentation UI for it.
+//
+//go:generate go run generate.go
 package swagger
 
 import (
-	"errors"
+	"bytes"
+	"embed"
+	"encoding/json"
 	"fmt"
+	"html/template"
+	"io/fs"
 	"log"
 	"net/http"
 	"net/url"
@@ -12,6 +19,29 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"gopkg.in/yaml.v3"
+)
+
+// embeddedUIAssets holds the Swagger UI dist assets generate.go downloads,
+// so the default SwaggerUI renderer works without an external CDN.
+//
+//go:embed embed/swagger-ui
+var embeddedUIAssets embed.FS
+
+// embeddedUIVersion is the swagger-ui-dist release baked into
+// embeddedUIAssets. Keep in sync with generate.go's swaggerUIVersion.
+const embeddedUIVersion = "5.17.14"
+
+// UIRenderer selects which documentation UI is served alongside the spec.
+type UIRenderer string
+
+const (
+	// SwaggerUI renders the spec with go-openapi's Swagger UI. This is the default.
+	SwaggerUI UIRenderer = "swagger"
+	// ReDoc renders the spec with go-openapi's ReDoc UI.
+	ReDoc UIRenderer = "redoc"
+	// RapiDoc renders the spec with the RapiDoc web component.
+	RapiDoc UIRenderer = "rapidoc"
 )
 
 // Config defines the config for middleware.
@@ -40,6 +70,95 @@ type Config struct {
 	//
 	// Optional. Default: Fiber API documentation
 	Title string
+
+	// UI selects which documentation renderer serves the spec.
+	//
+	// Optional. Default: SwaggerUI
+	UI UIRenderer
+
+	// Template overrides the generated documentation HTML entirely. It is
+	// parsed as an html/template with access to .SpecURL, .Title and
+	// .BasePath, and takes precedence over UI when non-empty. Useful for
+	// theming, injecting analytics, or pinning a specific UI asset version.
+	//
+	// Optional. Default: ""
+	Template string
+
+	// FS is an optional filesystem (e.g. embed.FS) to read the spec from
+	// instead of the local disk, so it can be embedded directly into the
+	// binary. When set, it takes precedence over FilePath as the spec
+	// source; FilePath is still used to build the served spec URL.
+	//
+	// Optional. Default: nil
+	FS fs.FS
+
+	// FSPath is the path to the swagger.json or swagger.yaml file within FS.
+	//
+	// Optional. Default: value of FilePath
+	FSPath string
+
+	// Spec is a spec already parsed via Load, shared with a sibling Validator
+	// middleware so the document is only parsed once. When set, it takes
+	// precedence over FilePath/FS/FSPath as the spec source; ignored when
+	// Specs is set.
+	//
+	// Optional. Default: loaded from FilePath/FS/FSPath
+	Spec *LoadedSpec
+
+	// Specs serves multiple spec versions from a single middleware instance
+	// and renders a version selector in the UI, e.g. to expose "v1", "v2"
+	// and "internal" side by side. When set, it takes precedence over
+	// FilePath/FS/FSPath/Spec.
+	//
+	// Optional. Default: nil
+	Specs []SpecEntry
+
+	// UseEmbeddedAssets serves the default SwaggerUI renderer entirely from
+	// the assets embedded via generate.go instead of go-openapi's
+	// CDN-backed page, for air-gapped deployments. Requires running
+	// `go generate ./...` to populate embed/swagger-ui with real
+	// swagger-ui-dist assets first; the repo ships with placeholder stubs
+	// there, so leave this false until that's done.
+	//
+	// Optional. Default: false
+	UseEmbeddedAssets bool
+
+	// UIVersion pins the Swagger UI asset version this middleware is
+	// expected to serve when UseEmbeddedAssets is true. It's checked
+	// against embeddedUIVersion, the version generate.go last embedded, so
+	// an outdated expectation after an asset upgrade (or vice versa) fails
+	// fast instead of silently serving a different version than pinned.
+	//
+	// Optional. Default: ""
+	UIVersion string
+}
+
+// SpecEntry describes a single spec served under Config.Specs. Each entry is
+// routed at "{BasePath}/{Name}.json" or "{BasePath}/{Name}.yaml", matching
+// the source file's extension.
+type SpecEntry struct {
+	// Name identifies the spec in its URL and in the UI's version selector,
+	// e.g. "v1".
+	Name string
+
+	// Title is the display name shown in the UI's version selector.
+	//
+	// Optional. Default: value of Name
+	Title string
+
+	// FilePath for this spec's swagger.json or swagger.yaml file.
+	FilePath string
+
+	// FS is an optional filesystem (e.g. embed.FS) to read this spec from
+	// instead of the local disk.
+	//
+	// Optional. Default: nil
+	FS fs.FS
+
+	// FSPath is the path to this spec's file within FS.
+	//
+	// Optional. Default: value of FilePath
+	FSPath string
 }
 
 // ConfigDefault is the default config
@@ -49,6 +168,334 @@ var ConfigDefault = Config{
 	FilePath: "./swagger.json",
 	Path:     "docs",
 	Title:    "Fiber API documentation",
+	UI:       SwaggerUI,
+}
+
+// renderOpts is the set of options shared by every UI renderer, kept in one
+// place so adding or tweaking a renderer can't drift out of sync with the
+// others.
+type renderOpts struct {
+	BasePath string
+	SpecURL  string
+	Path     string
+	Title    string
+}
+
+// templateData is exposed to Config.Template, the built-in RapiDoc page, the
+// embedded-asset SwaggerUI page, and the multi-spec version-selector page.
+// Not every renderer uses every field: AssetsPath only matters to the asset-
+// serving renderers, SpecsJSON only to the multi-spec one.
+type templateData struct {
+	SpecURL    string
+	Title      string
+	BasePath   string
+	AssetsPath string
+	SpecsJSON  template.JS
+}
+
+const rapiDocTemplate = `<!DOCTYPE html>
+<html>
+  <head>
+    <meta charset="utf-8">
+    <title>{{ .Title }}</title>
+    <script type="module" src="https://unpkg.com/rapidoc/dist/rapidoc-min.js"></script>
+  </head>
+  <body>
+    <rapi-doc spec-url="{{ .SpecURL }}" doc-title="{{ .Title }}"></rapi-doc>
+  </body>
+</html>`
+
+func renderTemplate(tpl string, data templateData) ([]byte, error) {
+	t, err := template.New("swagger").Parse(tpl)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// specSelectorEntry is one option in the Swagger UI version selector
+// rendered when Config.Specs is set.
+type specSelectorEntry struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// swaggerUIMultiTemplate renders the version-selector page for Config.Specs.
+// Like swaggerUITemplate, its JS/CSS come from .AssetsPath - go-openapi's CDN
+// by default, or embed/swagger-ui when Config.UseEmbeddedAssets is set - so
+// multi-spec mode follows the same air-gapped-deployment knob as single-spec.
+const swaggerUIMultiTemplate = `<!DOCTYPE html>
+<html>
+  <head>
+    <meta charset="utf-8">
+    <title>{{ .Title }}</title>
+    <link rel="stylesheet" href="{{ .AssetsPath }}swagger-ui.css">
+  </head>
+  <body>
+    <div id="swagger-ui"></div>
+    <script src="{{ .AssetsPath }}swagger-ui-bundle.js"></script>
+    <script>
+      window.onload = function() {
+        SwaggerUIBundle({
+          urls: {{ .SpecsJSON }},
+          dom_id: '#swagger-ui',
+        })
+      }
+    </script>
+  </body>
+</html>`
+
+// loadSpec reads a spec from fsys (when non-nil) or the local disk.
+func loadSpec(filePath string, fsys fs.FS, fsPath string) ([]byte, error) {
+	if fsys != nil {
+		path := fsPath
+		if len(path) == 0 {
+			path = filePath
+		}
+		return fs.ReadFile(fsys, path)
+	}
+
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return nil, fmt.Errorf("%s file does not exist", filePath)
+	}
+
+	return os.ReadFile(filePath)
+}
+
+// specHandlerEntry is one routable spec payload backing Config.Specs.
+type specHandlerEntry struct {
+	urlPath string
+	ext     string
+	raw     []byte
+}
+
+// fileExt returns ".json" or ".yaml" depending on the source file's
+// extension, defaulting to ".yaml" since that's what FilePath defaults to.
+func fileExt(path string) string {
+	if strings.HasSuffix(path, ".json") {
+		return ".json"
+	}
+	return ".yaml"
+}
+
+// requestExt prefers the extension on the request path itself (e.g. a spec
+// served at "docs.json") and falls back to the source file's extension.
+func requestExt(requestPath, fallback string) string {
+	switch {
+	case strings.HasSuffix(requestPath, ".json"):
+		return ".json"
+	case strings.HasSuffix(requestPath, ".yaml"), strings.HasSuffix(requestPath, ".yml"):
+		return ".yaml"
+	default:
+		return fallback
+	}
+}
+
+func contentTypeForExt(ext string) string {
+	if ext == ".json" {
+		return "application/json"
+	}
+	return "application/yaml"
+}
+
+func decodeSpec(raw []byte, ext string) (map[string]interface{}, error) {
+	doc := make(map[string]interface{})
+	if ext == ".json" {
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			return nil, err
+		}
+	} else if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func encodeSpec(doc map[string]interface{}, ext string) ([]byte, error) {
+	if ext == ".json" {
+		return json.Marshal(doc)
+	}
+	return yaml.Marshal(doc)
+}
+
+// rewriteHostScheme clones the spec and overwrites its Swagger 2.0 host and
+// schemes (or OpenAPI 3 servers) so a single deployed spec can target
+// different upstream hosts - staging, prod, per-tenant - without being
+// regenerated. Either host or scheme may be empty to leave it untouched.
+func rewriteHostScheme(raw []byte, ext, host, scheme string) ([]byte, error) {
+	doc, err := decodeSpec(raw, ext)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(host) > 0 {
+		doc["host"] = host
+	}
+	if len(scheme) > 0 {
+		doc["schemes"] = []string{scheme}
+	}
+
+	if servers, ok := doc["servers"].([]interface{}); ok {
+		for i, s := range servers {
+			entry, ok := s.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			serverURL, _ := entry["url"].(string)
+			u, err := url.Parse(serverURL)
+			if err != nil {
+				continue
+			}
+			if len(host) > 0 {
+				u.Host = host
+			}
+			if len(scheme) > 0 {
+				u.Scheme = scheme
+			}
+			entry["url"] = u.String()
+			servers[i] = entry
+		}
+		doc["servers"] = servers
+	}
+
+	return encodeSpec(doc, ext)
+}
+
+// specPayload resolves the bytes to serve for a single request: the raw spec
+// as-is, or a copy rewritten per the "host"/"scheme" query params.
+func specPayload(raw []byte, ext string, r *http.Request) ([]byte, error) {
+	host := r.URL.Query().Get("host")
+	scheme := r.URL.Query().Get("scheme")
+	if len(host) == 0 && len(scheme) == 0 {
+		return raw, nil
+	}
+	return rewriteHostScheme(raw, ext, host, scheme)
+}
+
+// matchesAnySpec reports whether path is the URL of one of the given specs.
+func matchesAnySpec(path string, specs []specSelectorEntry) bool {
+	for _, s := range specs {
+		if path == s.URL {
+			return true
+		}
+	}
+	return false
+}
+
+const swaggerUITemplate = `<!DOCTYPE html>
+<html>
+  <head>
+    <meta charset="utf-8">
+    <title>{{ .Title }}</title>
+    <link rel="stylesheet" href="{{ .AssetsPath }}swagger-ui.css">
+    <link rel="icon" type="image/png" href="{{ .AssetsPath }}favicon-32x32.png" sizes="32x32">
+    <link rel="icon" type="image/png" href="{{ .AssetsPath }}favicon-16x16.png" sizes="16x16">
+  </head>
+  <body>
+    <div id="swagger-ui"></div>
+    <script src="{{ .AssetsPath }}swagger-ui-bundle.js"></script>
+    <script src="{{ .AssetsPath }}swagger-ui-standalone-preset.js"></script>
+    <script>
+      window.onload = function() {
+        SwaggerUIBundle({
+          url: "{{ .SpecURL }}",
+          dom_id: "#swagger-ui",
+          presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+        })
+      }
+    </script>
+  </body>
+</html>`
+
+// embeddedAssetMinBytes is a floor real swagger-ui-dist assets clear by a
+// wide margin - even minified, the JS/CSS are tens of kilobytes and the
+// favicons a few hundred bytes - while the checked-in placeholder stubs
+// (a short comment, or a blank 1x1 PNG) fall well under it.
+const embeddedAssetMinBytes = 4096
+
+// favicon placeholders are tiny real PNGs rather than text, so they get
+// their own, lower floor instead of embeddedAssetMinBytes.
+const embeddedFaviconMinBytes = 200
+
+// validateEmbeddedAssets panics if Config.UseEmbeddedAssets is set but
+// embed/swagger-ui still holds the placeholder stubs checked in for `go
+// generate ./...` to replace, so a caller who forgets to run it fails loudly
+// at startup instead of silently serving a blank, broken documentation page.
+func validateEmbeddedAssets() {
+	textAssets := []string{"swagger-ui-bundle.js", "swagger-ui-standalone-preset.js", "swagger-ui.css"}
+	for _, name := range textAssets {
+		raw := mustReadEmbeddedAsset(name)
+		if len(raw) < embeddedAssetMinBytes || bytes.Contains(raw, []byte("Placeholder")) {
+			panicPlaceholderAsset(name)
+		}
+	}
+
+	favicons := []string{"favicon-16x16.png", "favicon-32x32.png"}
+	for _, name := range favicons {
+		if len(mustReadEmbeddedAsset(name)) < embeddedFaviconMinBytes {
+			panicPlaceholderAsset(name)
+		}
+	}
+}
+
+func mustReadEmbeddedAsset(name string) []byte {
+	raw, err := embeddedUIAssets.ReadFile("embed/swagger-ui/" + name)
+	if err != nil {
+		panic(fmt.Sprintf("swagger: failed to read embedded %s: %v", name, err))
+	}
+	return raw
+}
+
+func panicPlaceholderAsset(name string) {
+	panic(fmt.Sprintf("swagger: Config.UseEmbeddedAssets is true but embed/swagger-ui/%s is still the checked-in placeholder stub; run `go generate ./...` to populate real swagger-ui-dist assets first", name))
+}
+
+// embeddedAssetServer serves embeddedUIAssets (the JS/CSS/favicon files
+// generate.go downloads) under assetsPath, for the renderers that opt into
+// Config.UseEmbeddedAssets instead of pulling those assets from a CDN.
+func embeddedAssetServer(assetsPath string) http.Handler {
+	assetFS, err := fs.Sub(embeddedUIAssets, "embed/swagger-ui")
+	if err != nil {
+		panic(err)
+	}
+	return http.StripPrefix(assetsPath, http.FileServer(http.FS(assetFS)))
+}
+
+// htmlHandler serves a rendered HTML template for the UI path, delegating
+// any request whose path has one of specPaths as a suffix to specHandler,
+// and - when assets is non-nil - any request under assetsPath to assets. It
+// backs every renderer that isn't upstream go-openapi middleware:
+// Config.Template, RapiDoc, the embedded-asset SwaggerUI page, and the
+// multi-spec version-selector page.
+func htmlHandler(specPaths []string, tpl string, data templateData, specHandler http.Handler, assets http.Handler, assetsPath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, p := range specPaths {
+			if strings.HasSuffix(r.URL.Path, p) {
+				specHandler.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		if assets != nil && strings.HasPrefix(r.URL.Path, assetsPath) {
+			assets.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := renderTemplate(tpl, data)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(body)
+	})
 }
 
 // New creates a new middleware handler
@@ -73,56 +520,191 @@ func New(config ...Config) fiber.Handler {
 		if len(cfg.Title) == 0 {
 			cfg.Title = ConfigDefault.Title
 		}
+		if len(cfg.UI) == 0 {
+			cfg.UI = ConfigDefault.UI
+		}
 	}
 
-	// Verify Swagger file exists
-	if _, err := os.Stat(cfg.FilePath); os.IsNotExist(err) {
-		panic(errors.New(fmt.Sprintf("%s file does not exist", cfg.FilePath)))
-	}
+	if cfg.UseEmbeddedAssets {
+		validateEmbeddedAssets()
 
-	// Read Swagger Spec into memory
-	rawSpec, err := os.ReadFile(cfg.FilePath)
-	if err != nil {
-		log.Fatalf("Failed to read Swagger YAML file: %v", err)
-		panic(err)
+		if len(cfg.UIVersion) > 0 && cfg.UIVersion != embeddedUIVersion {
+			panic(fmt.Sprintf("swagger: Config.UIVersion %q does not match embedded Swagger UI assets %q; run `go generate ./...` to refresh them", cfg.UIVersion, embeddedUIVersion))
+		}
 	}
 
 	// Generate URL path's for the middleware
-	specURL, err := url.JoinPath(cfg.BasePath, cfg.FilePath)
-	if err != nil {
-		log.Fatalf("Failed to join URL path between %s and %s", cfg.BasePath, cfg.FilePath)
-		panic(err)
-	}
 	swaggerUIPath, err := url.JoinPath(cfg.BasePath, cfg.Path)
 	if err != nil {
 		log.Fatalf("UnaFailedble to join URL between %s and %s", cfg.BasePath, cfg.Path)
 		panic(err)
 	}
 
-	// Serve the Swagger spec from memory
-	swaggerSpecHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if strings.HasSuffix(r.URL.Path, specURL) || strings.HasSuffix(r.URL.Path, specURL) {
-			w.Header().Set("Content-Type", "application/yaml")
-			w.Write(rawSpec)
-		} else if strings.HasSuffix(r.URL.Path, specURL) {
-			w.Header().Set("Content-Type", "application/json")
-			w.Write(rawSpec)
-		} else {
-			fmt.Printf("Somehow here? %s\n", r.URL.Path)
+	var (
+		specURL            string
+		rawSpec            []byte
+		swaggerSpecHandler http.Handler
+		uiSpecs            []specSelectorEntry
+	)
+
+	if len(cfg.Specs) > 0 {
+		// Multiple spec versions: route "{BasePath}/{Name}.(json|yaml)" to
+		// each spec's own payload and list them all in the UI's version
+		// selector.
+		var multiSpecs []specHandlerEntry
+		for _, s := range cfg.Specs {
+			raw, loadErr := loadSpec(s.FilePath, s.FS, s.FSPath)
+			if loadErr != nil {
+				log.Fatalf("Failed to load spec %q: %v", s.Name, loadErr)
+				panic(loadErr)
+			}
+
+			title := s.Title
+			if len(title) == 0 {
+				title = s.Name
+			}
+
+			srcPath := s.FilePath
+			if len(s.FSPath) > 0 {
+				srcPath = s.FSPath
+			}
+			ext := fileExt(srcPath)
+
+			specPath, joinErr := url.JoinPath(cfg.BasePath, s.Name+ext)
+			if joinErr != nil {
+				log.Fatalf("Failed to join URL path for spec %q: %v", s.Name, joinErr)
+				panic(joinErr)
+			}
+
+			multiSpecs = append(multiSpecs, specHandlerEntry{urlPath: specPath, ext: ext, raw: raw})
+			uiSpecs = append(uiSpecs, specSelectorEntry{Name: title, URL: specPath})
+		}
+
+		swaggerSpecHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, s := range multiSpecs {
+				if strings.HasSuffix(r.URL.Path, s.urlPath) {
+					payload, err := specPayload(s.raw, s.ext, r)
+					if err != nil {
+						http.Error(w, err.Error(), http.StatusInternalServerError)
+						return
+					}
+					w.Header().Set("Content-Type", contentTypeForExt(s.ext))
+					w.Write(payload)
+					return
+				}
+			}
 			http.NotFound(w, r)
+		})
+	} else {
+		// Read the Swagger spec into memory - reusing an already-parsed
+		// Spec shared by a sibling Validator middleware if one was given,
+		// otherwise loading it fresh from the embedded FS or local disk.
+		if cfg.Spec != nil {
+			rawSpec = cfg.Spec.Raw
+		} else {
+			rawSpec, err = loadSpec(cfg.FilePath, cfg.FS, cfg.FSPath)
+			if err != nil {
+				log.Fatalf("Failed to read Swagger spec: %v", err)
+				panic(err)
+			}
 		}
-	})
 
-	// Define UI Options
-	swaggerUIOpts := middleware.SwaggerUIOpts{
+		specURL, err = url.JoinPath(cfg.BasePath, cfg.FilePath)
+		if err != nil {
+			log.Fatalf("Failed to join URL path between %s and %s", cfg.BasePath, cfg.FilePath)
+			panic(err)
+		}
+
+		fileExtension := fileExt(cfg.FilePath)
+
+		// Serve the Swagger spec from memory
+		swaggerSpecHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasSuffix(r.URL.Path, specURL) {
+				http.NotFound(w, r)
+				return
+			}
+
+			ext := requestExt(r.URL.Path, fileExtension)
+			payload, err := specPayload(rawSpec, ext, r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			w.Header().Set("Content-Type", contentTypeForExt(ext))
+			w.Write(payload)
+		})
+	}
+
+	opts := renderOpts{
 		BasePath: cfg.BasePath,
 		SpecURL:  specURL,
 		Path:     cfg.Path,
 		Title:    cfg.Title,
 	}
 
+	// Pick the renderer: multiple Specs always get the version-selector
+	// page, otherwise an explicit Template wins, otherwise branch on UI so
+	// all three single-spec renderers decode the same shared opts. The
+	// embedded-asset renderer is opt-in via UseEmbeddedAssets until real
+	// swagger-ui-dist assets are checked into embed/swagger-ui; until then
+	// SwaggerUI keeps using go-openapi's CDN-backed page.
+	var uiHandler http.Handler
+	usingEmbeddedAssets := false
+	switch {
+	case len(uiSpecs) > 0:
+		specPaths := make([]string, len(uiSpecs))
+		for i, s := range uiSpecs {
+			specPaths[i] = s.URL
+		}
+
+		data := templateData{Title: cfg.Title, BasePath: cfg.BasePath}
+		payload, jsonErr := json.Marshal(uiSpecs)
+		if jsonErr != nil {
+			log.Fatalf("Failed to marshal spec list: %v", jsonErr)
+			panic(jsonErr)
+		}
+		data.SpecsJSON = template.JS(payload)
+
+		var assetServer http.Handler
+		assetsPath := "https://unpkg.com/swagger-ui-dist/"
+		if cfg.UseEmbeddedAssets {
+			assetsPath = swaggerUIPath + "/"
+			assetServer = embeddedAssetServer(assetsPath)
+			usingEmbeddedAssets = true
+		}
+		data.AssetsPath = assetsPath
+
+		uiHandler = htmlHandler(specPaths, swaggerUIMultiTemplate, data, swaggerSpecHandler, assetServer, assetsPath)
+	case len(cfg.Template) > 0:
+		data := templateData{SpecURL: opts.SpecURL, Title: opts.Title, BasePath: opts.BasePath}
+		uiHandler = htmlHandler([]string{opts.SpecURL}, cfg.Template, data, swaggerSpecHandler, nil, "")
+	case cfg.UI == ReDoc:
+		uiHandler = middleware.Redoc(middleware.RedocOpts{
+			BasePath: opts.BasePath,
+			SpecURL:  opts.SpecURL,
+			Path:     opts.Path,
+			Title:    opts.Title,
+		}, swaggerSpecHandler)
+	case cfg.UI == RapiDoc:
+		data := templateData{SpecURL: opts.SpecURL, Title: opts.Title, BasePath: opts.BasePath}
+		uiHandler = htmlHandler([]string{opts.SpecURL}, rapiDocTemplate, data, swaggerSpecHandler, nil, "")
+	case cfg.UseEmbeddedAssets:
+		assetsPath := swaggerUIPath + "/"
+		data := templateData{SpecURL: opts.SpecURL, Title: opts.Title, AssetsPath: assetsPath}
+		uiHandler = htmlHandler([]string{opts.SpecURL}, swaggerUITemplate, data, swaggerSpecHandler, embeddedAssetServer(assetsPath), assetsPath)
+		usingEmbeddedAssets = true
+	default:
+		uiHandler = middleware.SwaggerUI(middleware.SwaggerUIOpts{
+			BasePath: opts.BasePath,
+			SpecURL:  opts.SpecURL,
+			Path:     opts.Path,
+			Title:    opts.Title,
+		}, swaggerSpecHandler)
+	}
+
 	// Create UI middleware
-	middlewareHandler := adaptor.HTTPHandler(middleware.SwaggerUI(swaggerUIOpts, swaggerSpecHandler))
+	middlewareHandler := adaptor.HTTPHandler(uiHandler)
 
 	// Return new handler
 	return func(c *fiber.Ctx) error {
@@ -131,22 +713,15 @@ func New(config ...Config) fiber.Handler {
 			return c.Next()
 		}
 
-		// Only respond to requests to this middleware
-		if !(c.Path() == swaggerUIPath || c.Path() == specURL) {
-			fmt.Println("-----")
-			fmt.Printf("c.Path() is %s\n", c.Path())
-			fmt.Printf("BasePath is %s\n", cfg.BasePath)
-			fmt.Printf("swaggerUIPath is %s\n", swaggerUIPath)
-			fmt.Printf("specURL is %s\n", specURL)
-
+		// Only respond to requests to this middleware. When serving the
+		// embedded SwaggerUI, its own JS/CSS/favicon assets live under
+		// swaggerUIPath too, hence the prefix check.
+		matchesThisMiddleware := c.Path() == swaggerUIPath ||
+			c.Path() == specURL ||
+			matchesAnySpec(c.Path(), uiSpecs) ||
+			(usingEmbeddedAssets && strings.HasPrefix(c.Path(), swaggerUIPath+"/"))
+		if !matchesThisMiddleware {
 			return c.Next()
-		} else {
-			fmt.Println("+++++")
-			fmt.Printf("c.Path() is %s\n", c.Path())
-			fmt.Printf("BasePath is %s\n", cfg.BasePath)
-			fmt.Printf("swaggerUIPath is %s\n", swaggerUIPath)
-			fmt.Printf("specURL is %s\n", specURL)
-
 		}
 
 		// Pass Fiber context to handler
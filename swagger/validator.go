@@ -0,0 +1,198 @@
+package swagger
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorilla"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+)
+
+// LoadedSpec is a parsed OpenAPI document shared between New (UI) and
+// Validator (enforcement), so both decode the same spec bytes exactly once
+// and stay in lockstep with what's actually served.
+type LoadedSpec struct {
+	Raw    []byte
+	Doc    *openapi3.T
+	Router routers.Router
+}
+
+// Load parses the spec referenced by cfg's FilePath/FS/FSPath once. Pass the
+// result to both New's Config.Spec and Validator's ValidatorConfig.Spec to
+// avoid parsing the same document twice.
+func Load(cfg Config) (*LoadedSpec, error) {
+	raw, err := loadSpec(cfg.FilePath, cfg.FS, cfg.FSPath)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := openapi3.NewLoader().LoadFromData(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI spec: %w", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI spec: %w", err)
+	}
+
+	router, err := gorilla.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OpenAPI router: %w", err)
+	}
+
+	return &LoadedSpec{Raw: raw, Doc: doc, Router: router}, nil
+}
+
+// ValidatorConfig defines the config for Validator.
+type ValidatorConfig struct {
+	// Next defines a function to skip this middleware when returned true.
+	//
+	// Optional. Default: nil
+	Next func(c *fiber.Ctx) bool
+
+	// Spec is a spec already parsed via Load, shared with a sibling New
+	// middleware so the document is only parsed once.
+	//
+	// Optional. Default: loaded from FilePath/FS/FSPath
+	Spec *LoadedSpec
+
+	// FilePath for the swagger.json or swagger.yaml file. Ignored when Spec
+	// is set.
+	//
+	// Optional. Default: ./swagger.json
+	FilePath string
+
+	// FS is an optional filesystem (e.g. embed.FS) to read the spec from.
+	// Ignored when Spec is set.
+	//
+	// Optional. Default: nil
+	FS fs.FS
+
+	// FSPath is the path to the spec file within FS. Ignored when Spec is
+	// set.
+	//
+	// Optional. Default: value of FilePath
+	FSPath string
+
+	// Strict rejects requests whose method and path don't match any
+	// operation in the spec with an error instead of passing them through
+	// to the next handler.
+	//
+	// Optional. Default: false
+	Strict bool
+
+	// ErrorHandler customizes how a validation failure is rendered.
+	//
+	// Optional. Default: a JSON {"error": "..."} body, 401 for security
+	// failures and 400 for everything else.
+	ErrorHandler func(c *fiber.Ctx, err error) error
+
+	// AuthenticationFunc is called to verify the operation's security
+	// requirements, if any (e.g. checking the request against openapi3's
+	// AuthenticationInput). openapi3filter requires one to be set whenever
+	// the spec declares a security scheme; it has no safe default, so the
+	// zero value here rejects every such operation until this is provided.
+	//
+	// Optional. Default: rejects all security requirements
+	AuthenticationFunc openapi3filter.AuthenticationFunc
+}
+
+// ValidatorConfigDefault is the default ValidatorConfig.
+var ValidatorConfigDefault = ValidatorConfig{
+	Next:               nil,
+	FilePath:           "./swagger.json",
+	AuthenticationFunc: denyAllAuthenticationFunc,
+}
+
+// denyAllAuthenticationFunc is the default AuthenticationFunc: it rejects
+// every security requirement, so specs without a security scheme validate
+// exactly as before and specs with one fail closed until the caller supplies
+// their own AuthenticationFunc.
+func denyAllAuthenticationFunc(_ context.Context, input *openapi3filter.AuthenticationInput) error {
+	return fmt.Errorf("security scheme %q is not supported: no AuthenticationFunc configured", input.SecuritySchemeName)
+}
+
+// Validator creates an OpenAPI request-validation middleware tied to the
+// same spec served by New. It validates incoming requests' parameters,
+// request bodies and security schemes against the spec, returning a 4xx
+// response on failure instead of reaching the route handler.
+func Validator(config ...ValidatorConfig) fiber.Handler {
+	// Set default config
+	cfg := ValidatorConfigDefault
+
+	// Override config if provided
+	if len(config) > 0 {
+		cfg = config[0]
+
+		// Set default values
+		if cfg.Spec == nil && len(cfg.FilePath) == 0 {
+			cfg.FilePath = ValidatorConfigDefault.FilePath
+		}
+	}
+
+	spec := cfg.Spec
+	if spec == nil {
+		loaded, err := Load(Config{FilePath: cfg.FilePath, FS: cfg.FS, FSPath: cfg.FSPath})
+		if err != nil {
+			panic(err)
+		}
+		spec = loaded
+	}
+
+	errorHandler := cfg.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = defaultValidatorErrorHandler
+	}
+
+	authFunc := cfg.AuthenticationFunc
+	if authFunc == nil {
+		authFunc = denyAllAuthenticationFunc
+	}
+
+	return func(c *fiber.Ctx) error {
+		// Don't execute middleware if Next returns true
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		req, err := adaptor.ConvertRequest(c, false)
+		if err != nil {
+			return errorHandler(c, err)
+		}
+
+		route, pathParams, err := spec.Router.FindRoute(req)
+		if err != nil {
+			if cfg.Strict {
+				return errorHandler(c, err)
+			}
+			return c.Next()
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				AuthenticationFunc: authFunc,
+			},
+		}
+		if err := openapi3filter.ValidateRequest(c.Context(), input); err != nil {
+			return errorHandler(c, err)
+		}
+
+		return c.Next()
+	}
+}
+
+func defaultValidatorErrorHandler(c *fiber.Ctx, err error) error {
+	status := fiber.StatusBadRequest
+	if _, ok := err.(*openapi3filter.SecurityRequirementsError); ok {
+		status = fiber.StatusUnauthorized
+	}
+
+	return c.Status(status).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,96 @@
+package swagger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func newValidatorApp(t *testing.T, cfg ValidatorConfig) *fiber.App {
+	t.Helper()
+
+	app := fiber.New()
+	app.Use(Validator(cfg))
+	app.Get("/secure", func(c *fiber.Ctx) error { return c.SendString("reached") })
+	app.Post("/items", func(c *fiber.Ctx) error { return c.SendString("reached") })
+	return app
+}
+
+func TestValidator_SecurityWithoutAuthenticationFuncRejectsInsteadOfPanicking(t *testing.T) {
+	app := newValidatorApp(t, ValidatorConfig{FilePath: "testdata/validator_spec.json"})
+
+	req := httptest.NewRequest(http.MethodGet, "/secure", nil)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func TestValidator_Strict(t *testing.T) {
+	tests := []struct {
+		name       string
+		strict     bool
+		wantStatus int
+	}{
+		{"strict rejects unmatched routes", true, http.StatusBadRequest},
+		{"non-strict passes unmatched routes through", false, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newValidatorApp(t, ValidatorConfig{
+				FilePath: "testdata/validator_spec.json",
+				Strict:   tt.strict,
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/does-not-exist", nil)
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestValidator_RequestBody(t *testing.T) {
+	app := newValidatorApp(t, ValidatorConfig{FilePath: "testdata/validator_spec.json"})
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{"valid body passes through", `{"name":"widget"}`, http.StatusOK},
+		{"missing required field fails validation", `{}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/items", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}